@@ -0,0 +1,86 @@
+// Package cache is a small filesystem-backed, TTL-based cache for raw API
+// responses. It's shared by every weather provider so each one gets
+// stale-while-error behavior for free.
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// dir is where cached responses are stored on disk. It's a var rather than
+// a const so tests can point it at a temporary directory.
+var dir = ".cache/weather-forecast"
+
+// entry is the on-disk representation of a single cached response.
+type entry struct {
+	FetchedAt time.Time       `json:"fetched_at"`
+	Body      json.RawMessage `json:"body"`
+}
+
+// hashKey derives a stable, opaque token for a given cache key string
+// (typically a full request URL, query parameters and all). Hashing it
+// means cache filenames are path-safe and logged cache keys never leak
+// secrets that ride along in the URL, like an API key query parameter.
+func hashKey(k string) string {
+	sum := sha256.Sum256([]byte(k))
+	return hex.EncodeToString(sum[:])
+}
+
+func path(k string) string {
+	return filepath.Join(dir, hashKey(k)+".json")
+}
+
+func load(k string, ttl time.Duration) (e entry, fresh bool, exists bool) {
+	data, err := os.ReadFile(path(k))
+	if err != nil {
+		return entry{}, false, false
+	}
+	if err := json.Unmarshal(data, &e); err != nil {
+		return entry{}, false, false
+	}
+	return e, time.Since(e.FetchedAt) < ttl, true
+}
+
+func store(k string, body []byte) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		log.Printf("cache: could not create cache dir: %v", err)
+		return
+	}
+	e := entry{FetchedAt: time.Now(), Body: json.RawMessage(body)}
+	data, err := json.Marshal(e)
+	if err != nil {
+		log.Printf("cache: could not marshal cache entry: %v", err)
+		return
+	}
+	if err := os.WriteFile(path(k), data, 0o644); err != nil {
+		log.Printf("cache: could not write cache entry: %v", err)
+	}
+}
+
+// Fetch serves key from the on-disk cache when a fresh entry exists (within
+// ttl), otherwise calls fetchLive and refreshes the cache. If fetchLive
+// fails but a stale entry is on disk, that stale entry is served instead of
+// failing the request outright.
+func Fetch(key string, ttl time.Duration, fetchLive func() ([]byte, error)) ([]byte, error) {
+	if e, fresh, exists := load(key, ttl); exists && fresh {
+		return e.Body, nil
+	}
+
+	body, err := fetchLive()
+	if err != nil {
+		if e, _, exists := load(key, ttl); exists {
+			log.Printf("cache: %v; serving stale cache for %s", err, hashKey(key))
+			return e.Body, nil
+		}
+		return nil, err
+	}
+
+	store(key, body)
+	return body, nil
+}
@@ -0,0 +1,98 @@
+package cache
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// withTempDir points the package-level cache dir at a fresh temp directory
+// for the duration of the test.
+func withTempDir(t *testing.T) {
+	t.Helper()
+	prev := dir
+	dir = t.TempDir()
+	t.Cleanup(func() { dir = prev })
+}
+
+func TestFetchCachesFreshEntry(t *testing.T) {
+	withTempDir(t)
+
+	calls := 0
+	fetchLive := func() ([]byte, error) {
+		calls++
+		return []byte(`"live"`), nil
+	}
+
+	body, err := Fetch("key", time.Minute, fetchLive)
+	if err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+	if string(body) != `"live"` {
+		t.Fatalf("unexpected body: %s", body)
+	}
+
+	body, err = Fetch("key", time.Minute, fetchLive)
+	if err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+	if string(body) != `"live"` {
+		t.Fatalf("unexpected body: %s", body)
+	}
+	if calls != 1 {
+		t.Fatalf("expected fetchLive to run once, ran %d times", calls)
+	}
+}
+
+func TestFetchRefetchesAfterExpiry(t *testing.T) {
+	withTempDir(t)
+
+	calls := 0
+	fetchLive := func() ([]byte, error) {
+		calls++
+		return []byte(`"live"`), nil
+	}
+
+	if _, err := Fetch("key", -time.Second, fetchLive); err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+	if _, err := Fetch("key", -time.Second, fetchLive); err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected fetchLive to run twice with an already-expired ttl, ran %d times", calls)
+	}
+}
+
+func TestFetchServesStaleEntryOnError(t *testing.T) {
+	withTempDir(t)
+
+	if _, err := Fetch("key", -time.Second, func() ([]byte, error) {
+		return []byte(`"stale"`), nil
+	}); err != nil {
+		t.Fatalf("seeding fetch: %v", err)
+	}
+
+	errUpstream := errors.New("upstream unavailable")
+	body, err := Fetch("key", -time.Second, func() ([]byte, error) {
+		return nil, errUpstream
+	})
+	if err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+	if string(body) != `"stale"` {
+		t.Fatalf("expected stale body to be served, got %q", body)
+	}
+}
+
+func TestFetchFailsWithNoCacheAndNoLiveData(t *testing.T) {
+	withTempDir(t)
+
+	errUpstream := errors.New("upstream unavailable")
+	_, err := Fetch("key", time.Minute, func() ([]byte, error) {
+		return nil, errUpstream
+	})
+	if !errors.Is(err, errUpstream) {
+		t.Fatalf("expected errUpstream, got %v", err)
+	}
+}
@@ -0,0 +1,98 @@
+// Package grpcserver implements the Weather gRPC service declared in
+// proto/weather.proto, delegating to the weather package for the actual
+// geocoding and forecast lookups.
+package grpcserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stn1sv/weather-forecast/proto"
+	"github.com/stn1sv/weather-forecast/weather"
+)
+
+// Server implements proto.WeatherServer.
+type Server struct {
+	proto.UnimplementedWeatherServer
+}
+
+// New creates a Weather gRPC server.
+func New() *Server {
+	return &Server{}
+}
+
+func (s *Server) Location(ctx context.Context, req *proto.LocationRequest) (*proto.LocationReply, error) {
+	latLong, err := weather.GetLatLong(ctx, req.GetCity())
+	if err != nil {
+		return nil, err
+	}
+	return &proto.LocationReply{Latitude: latLong.Latitude, Longitude: latLong.Longitude}, nil
+}
+
+func (s *Server) Current(ctx context.Context, req *proto.CurrentRequest) (*proto.CurrentReply, error) {
+	latLong, err := resolveLocation(ctx, req.GetLocation())
+	if err != nil {
+		return nil, err
+	}
+
+	points, err := weather.GetForecast(ctx, *latLong, unitsFromProto(req.GetUnits()))
+	if err != nil {
+		return nil, err
+	}
+	if len(points) == 0 {
+		return nil, fmt.Errorf("no forecast data returned")
+	}
+
+	return &proto.CurrentReply{Temperature: points[0].Temperature}, nil
+}
+
+func (s *Server) FiveDay(ctx context.Context, req *proto.FiveDayRequest) (*proto.FiveDayReply, error) {
+	latLong, err := resolveLocation(ctx, req.GetLocation())
+	if err != nil {
+		return nil, err
+	}
+
+	days, err := weather.GetDailyForecast(ctx, *latLong, unitsFromProto(req.GetUnits()))
+	if err != nil {
+		return nil, err
+	}
+	if len(days) > 5 {
+		days = days[:5]
+	}
+
+	reply := &proto.FiveDayReply{Days: make([]*proto.DailyPoint, 0, len(days))}
+	for _, d := range days {
+		reply.Days = append(reply.Days, &proto.DailyPoint{
+			Date:             d.Date,
+			TemperatureMax:   d.TemperatureMax,
+			TemperatureMin:   d.TemperatureMin,
+			PrecipitationSum: d.PrecipitationSum,
+			Sunrise:          d.Sunrise,
+			Sunset:           d.Sunset,
+		})
+	}
+	return reply, nil
+}
+
+// resolveLocation turns a proto Location oneof into coordinates, geocoding
+// the city if that's how the caller identified the place.
+func resolveLocation(ctx context.Context, loc *proto.Location) (*weather.LatLong, error) {
+	if loc == nil {
+		return nil, fmt.Errorf("location is required")
+	}
+	if coords := loc.GetCoords(); coords != nil {
+		return &weather.LatLong{Latitude: coords.Latitude, Longitude: coords.Longitude}, nil
+	}
+	return weather.GetLatLong(ctx, loc.GetCity())
+}
+
+func unitsFromProto(u proto.Units) weather.Units {
+	switch u {
+	case proto.Units_IMPERIAL:
+		return weather.UnitsImperial
+	case proto.Units_STANDARD:
+		return weather.UnitsStandard
+	default:
+		return weather.UnitsMetric
+	}
+}
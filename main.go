@@ -1,53 +1,113 @@
 package main
 
 import (
-	"encoding/json"
 	"errors"
+	"flag"
 	"fmt"
 	"html/template"
-	"io"
 	"io/ioutil"
 	"log"
+	"net"
 	"net/http"
-	"net/url"
+	"os"
 	"time"
-)
 
-type GeoResponse struct {
-	Results []LatLong `json:"results"`
-}
+	"google.golang.org/grpc"
 
-type LatLong struct {
-	Latitude  float64 `json:"latitude"`
-	Longitude float64 `json:"longitude"`
-}
+	"github.com/stn1sv/weather-forecast/grpcserver"
+	"github.com/stn1sv/weather-forecast/proto"
+	"github.com/stn1sv/weather-forecast/provider"
+	"github.com/stn1sv/weather-forecast/weather"
+)
 
 type WeatherDisplay struct {
 	City      string
+	Current   CurrentConditions
 	Forecasts []Forecast
 }
 
+// CurrentConditions is the at-a-glance card shown at the top of
+// views/weather.html, taken from the soonest hourly point.
+type CurrentConditions struct {
+	Temperature   string
+	Humidity      string
+	WindSpeed     string
+	WindDirection string
+	Condition     string
+	Icon          string
+}
+
 type Forecast struct {
 	Date        string
 	Temperature string
+	Condition   string
+	Icon        string
+}
+
+type DailyDisplay struct {
+	City string
+	Days []DailyForecast
 }
 
-type WeatherResponse struct {
-	Latitude  float64 `json:"latitude"`
-	Longitude float64 `json:"longitude"`
-	Timezone  string  `json:"timezone"`
-	Hourly    struct {
-		Time          []int64   `json:"time"`
-		Temperature2m []float64 `json:"temperature_2m"`
-	} `json:"hourly"`
+type DailyForecast struct {
+	Date             string
+	TemperatureMax   string
+	TemperatureMin   string
+	PrecipitationSum string
+	Sunrise          string
+	Sunset           string
+	Condition        string
+	Icon             string
+}
+
+var (
+	grpcAddr     = flag.String("grpc-addr", "localhost:9090", "address to serve the gRPC Weather service on")
+	providerName = flag.String("provider", envOr("WEATHER_PROVIDER", "open-meteo"), "weather provider to use: open-meteo, openweathermap, yrno")
+	providerKey  = flag.String("provider-api-key", os.Getenv("WEATHER_PROVIDER_API_KEY"), "API key for providers that require one (e.g. openweathermap)")
+)
+
+// envOr returns the environment variable key if set, otherwise fallback. It
+// lets flag defaults honor env vars without overriding an explicit flag.
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
 }
 
 func main() {
+	flag.Parse()
+
+	if err := weather.SelectProvider(*providerName, *providerKey); err != nil {
+		log.Fatalf("weather: %v", err)
+	}
+
+	go serveGRPC(*grpcAddr)
+
 	http.HandleFunc("/", home)
 	http.HandleFunc("/weather", handler)
+	http.HandleFunc("/weather/daily", dailyHandler)
+	http.Handle("/static/", http.StripPrefix("/static/", http.FileServer(http.Dir("static"))))
 	log.Fatal(http.ListenAndServe("localhost:8080", nil))
 }
 
+// serveGRPC starts the Weather gRPC service on addr. It runs for the
+// lifetime of the process, alongside the HTML UI on the HTTP port.
+func serveGRPC(addr string) {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatalf("grpc: failed to listen on %s: %v", addr, err)
+	}
+
+	srv := grpc.NewServer()
+	proto.RegisterWeatherServer(srv, grpcserver.New())
+
+	log.Printf("grpc: serving Weather service on %s", addr)
+	if err := srv.Serve(lis); err != nil {
+		log.Fatalf("grpc: server stopped: %v", err)
+	}
+}
+
 func home(w http.ResponseWriter, r *http.Request) {
 	file, err := ioutil.ReadFile("views/index.html")
 	if err != nil {
@@ -71,80 +131,154 @@ func handler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	city := r.FormValue("city")
+	units := unitsFromQuery(r.FormValue("units"))
+	ctx := r.Context()
 
-	latLong, err := getLatLong(city)
+	latLong, err := weather.GetLatLong(ctx, city)
 	if err != nil {
-		http.Error(w, "internal server error", http.StatusInternalServerError)
+		http.Error(w, err.Error(), statusFor(err))
 		return
 	}
 
-	weather, err := getWeather(*latLong)
+	points, err := weather.GetForecast(ctx, *latLong, units)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), statusFor(err))
 		return
 	}
 
-	data, err := extractWeatherData(city, weather)
-	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
+	data := toDisplay(city, points, units)
 
 	tmpl, _ := template.ParseFiles("views/weather.html")
 	tmpl.Execute(w, data)
 }
 
-func getLatLong(city string) (*LatLong, error) {
-	endpoint := fmt.Sprintf("https://geocoding-api.open-meteo.com/v1/search?name=%s&count=1&language=ru&format=json", url.QueryEscape(city))
-	resp, err := http.Get(endpoint)
+func dailyHandler(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/weather/daily" {
+		http.NotFound(w, r)
+		return
+	}
+
+	err := r.ParseForm()
 	if err != nil {
-		return nil, fmt.Errorf("error making request to Geo API: %w", err)
+		http.Error(w, "could not parse the form", http.StatusInternalServerError)
+		return
 	}
-	defer resp.Body.Close()
+	city := r.FormValue("city")
+	units := unitsFromQuery(r.FormValue("units"))
+	ctx := r.Context()
 
-	var response GeoResponse
-	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
-		return nil, fmt.Errorf("error decoding response: %w", err)
+	latLong, err := weather.GetLatLong(ctx, city)
+	if err != nil {
+		http.Error(w, err.Error(), statusFor(err))
+		return
 	}
-	if len(response.Results) < 1 {
-		return nil, errors.New("no results found")
+
+	days, err := weather.GetDailyForecast(ctx, *latLong, units)
+	if err != nil {
+		http.Error(w, err.Error(), statusFor(err))
+		return
 	}
 
-	return &response.Results[0], nil
+	data := toDailyDisplay(city, days, units)
+
+	tmpl, _ := template.ParseFiles("views/daily.html")
+	tmpl.Execute(w, data)
 }
 
-func getWeather(latLong LatLong) (string, error) {
-	endpoint := fmt.Sprintf("https://api.open-meteo.com/v1/forecast?latitude=%.6f&longitude=%.6f&hourly=temperature_2m&timeformat=unixtime", latLong.Latitude, latLong.Longitude)
-	resp, err := http.Get(endpoint)
-	if err != nil {
-		return "", fmt.Errorf("error making request to Weather API: %w", err)
+// statusFor translates a typed upstream error from the weather package into
+// the HTTP status code that best reflects it, falling back to 500 for
+// anything else.
+func statusFor(err error) int {
+	switch {
+	case errors.Is(err, weather.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, weather.ErrRateLimited):
+		return http.StatusTooManyRequests
+	case errors.Is(err, weather.ErrUpstream):
+		return http.StatusBadGateway
+	default:
+		return http.StatusInternalServerError
 	}
-	defer resp.Body.Close()
+}
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return "", fmt.Errorf("error reading response body %w", err)
+// unitsFromQuery maps a `?units=` value to a weather.Units, defaulting to
+// metric for anything unrecognized (including an absent/empty param).
+func unitsFromQuery(v string) weather.Units {
+	if v == "imperial" {
+		return weather.UnitsImperial
 	}
-	return string(body), nil
+	return weather.UnitsMetric
 }
 
-func extractWeatherData(city string, rawWeather string) (WeatherDisplay, error) {
-	var weatherResponse WeatherResponse
-	if err := json.Unmarshal([]byte(rawWeather), &weatherResponse); err != nil {
-		return WeatherDisplay{}, fmt.Errorf("error decoding weather response: %w", err)
+// degreeSuffix returns the temperature suffix for units, e.g. "°C"/"°F".
+func degreeSuffix(units weather.Units) string {
+	if units == weather.UnitsImperial {
+		return "°F"
 	}
+	return "°C"
+}
+
+// windspeedUnitLabel returns the display label for wind speed in units.
+func windspeedUnitLabel(units weather.Units) string {
+	if units == weather.UnitsImperial {
+		return "mph"
+	}
+	return "km/h"
+}
 
-	var forecasts []Forecast
-	for i, t := range weatherResponse.Hourly.Time {
-		date := time.Unix(t, 0)
-		forecast := Forecast{
-			Date:        date.Format("Mon 15:04"),
-			Temperature: fmt.Sprintf("%.1fÂ°C", weatherResponse.Hourly.Temperature2m[i]),
+// toDisplay formats raw hourly points into the strings the HTML template
+// renders. Every provider normalizes its timestamps to Open-Meteo's
+// pre-shifted unixtime convention (see provider.HourlyPoint), so formatting
+// them with time.Time.UTC gives the city's local wall-clock time rather
+// than the server's.
+func toDisplay(city string, points []weather.HourlyPoint, units weather.Units) WeatherDisplay {
+	forecasts := make([]Forecast, 0, len(points))
+	for _, p := range points {
+		condition := provider.ConditionFromCode(p.WeatherCode)
+		forecasts = append(forecasts, Forecast{
+			Date:        time.Unix(p.Time, 0).UTC().Format("Mon 15:04"),
+			Temperature: fmt.Sprintf("%.1f%s", p.Temperature, degreeSuffix(units)),
+			Condition:   condition.Description,
+			Icon:        condition.Icon,
+		})
+	}
+
+	var current CurrentConditions
+	if len(points) > 0 {
+		p := points[0]
+		condition := provider.ConditionFromCode(p.WeatherCode)
+		current = CurrentConditions{
+			Temperature:   fmt.Sprintf("%.1f%s", p.Temperature, degreeSuffix(units)),
+			Humidity:      fmt.Sprintf("%.0f%%", p.Humidity),
+			WindSpeed:     fmt.Sprintf("%.1f %s", p.WindSpeed, windspeedUnitLabel(units)),
+			WindDirection: fmt.Sprintf("%.0f°", p.WindDirection),
+			Condition:     condition.Description,
+			Icon:          condition.Icon,
 		}
-		forecasts = append(forecasts, forecast)
 	}
-	return WeatherDisplay{
-		City:      city,
-		Forecasts: forecasts,
-	}, nil
+
+	return WeatherDisplay{City: city, Current: current, Forecasts: forecasts}
+}
+
+// toDailyDisplay formats raw daily points into the strings views/daily.html
+// renders. Every provider normalizes its timestamps to Open-Meteo's
+// pre-shifted unixtime convention (see provider.HourlyPoint), so formatting
+// them with time.Time.UTC gives the city's local wall-clock time rather
+// than the server's.
+func toDailyDisplay(city string, points []weather.DailyPoint, units weather.Units) DailyDisplay {
+	days := make([]DailyForecast, 0, len(points))
+	for _, p := range points {
+		condition := provider.ConditionFromCode(p.WeatherCode)
+		days = append(days, DailyForecast{
+			Date:             time.Unix(p.Date, 0).UTC().Format("Mon Jan 2"),
+			TemperatureMax:   fmt.Sprintf("%.1f%s", p.TemperatureMax, degreeSuffix(units)),
+			TemperatureMin:   fmt.Sprintf("%.1f%s", p.TemperatureMin, degreeSuffix(units)),
+			PrecipitationSum: fmt.Sprintf("%.1f mm", p.PrecipitationSum),
+			Sunrise:          time.Unix(p.Sunrise, 0).UTC().Format("15:04"),
+			Sunset:           time.Unix(p.Sunset, 0).UTC().Format("15:04"),
+			Condition:        condition.Description,
+			Icon:             condition.Icon,
+		})
+	}
+	return DailyDisplay{City: city, Days: days}
 }
@@ -0,0 +1,4 @@
+// Package proto holds the generated client/server code for weather.proto.
+//
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative weather.proto
+package proto
@@ -0,0 +1,25 @@
+package provider
+
+import "context"
+
+// Fake is an in-memory Provider for tests: it performs no network calls and
+// returns whatever its fields are set to.
+type Fake struct {
+	LatLong  LatLong
+	Forecast Forecast
+	Err      error
+}
+
+// NewFake returns a Provider that always returns latLong and forecast, or
+// err if set.
+func NewFake(latLong LatLong, forecast Forecast, err error) *Fake {
+	return &Fake{LatLong: latLong, Forecast: forecast, Err: err}
+}
+
+func (p *Fake) Geocode(ctx context.Context, city string) (LatLong, error) {
+	return p.LatLong, p.Err
+}
+
+func (p *Fake) Fetch(ctx context.Context, latLong LatLong, opts Options) (Forecast, error) {
+	return p.Forecast, p.Err
+}
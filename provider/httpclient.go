@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// Typed upstream errors so callers (the HTTP handler, the gRPC service) can
+// translate a failure into the right status code instead of always
+// returning 500/Internal.
+var (
+	ErrNotFound    = errors.New("upstream: not found")
+	ErrRateLimited = errors.New("upstream: rate limited")
+	ErrUpstream    = errors.New("upstream: request failed")
+)
+
+// httpClient is shared by every provider. Per-request timeouts are applied
+// via the request's context rather than Client.Timeout, so a slow upstream
+// can't outlast the caller's own deadline (e.g. r.Context() from an HTTP
+// handler).
+var httpClient = &http.Client{}
+
+const (
+	requestTimeout = 10 * time.Second
+	maxAttempts    = 4
+	baseBackoff    = 200 * time.Millisecond
+)
+
+// getBody performs a GET against endpoint with the given headers, retrying
+// transient failures (network errors, 429, 5xx) with exponential backoff
+// and jitter. It returns a typed error (ErrNotFound, ErrRateLimited,
+// ErrUpstream) when the final attempt still fails.
+func getBody(ctx context.Context, endpoint string, headers map[string]string) ([]byte, error) {
+	var lastErr error
+
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		if attempt > 0 {
+			if err := sleep(ctx, backoff(attempt)); err != nil {
+				return nil, err
+			}
+		}
+
+		body, retryable, err := doRequest(ctx, endpoint, headers)
+		if err == nil {
+			return body, nil
+		}
+		lastErr = err
+		if !retryable {
+			return nil, err
+		}
+	}
+
+	return nil, lastErr
+}
+
+// doRequest performs a single attempt. retryable reports whether the
+// caller should back off and try again.
+func doRequest(ctx context.Context, endpoint string, headers map[string]string) (body []byte, retryable bool, err error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, true, fmt.Errorf("%w: %v", ErrUpstream, err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, false, ErrNotFound
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return nil, true, ErrRateLimited
+	case resp.StatusCode >= 500:
+		return nil, true, fmt.Errorf("%w: status %d", ErrUpstream, resp.StatusCode)
+	case resp.StatusCode >= 400:
+		return nil, false, fmt.Errorf("%w: status %d", ErrUpstream, resp.StatusCode)
+	}
+
+	body, err = io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, true, fmt.Errorf("%w: %v", ErrUpstream, err)
+	}
+	return body, false, nil
+}
+
+// backoff returns the exponential delay before retry attempt n (1-indexed),
+// plus up to 50% jitter so concurrent requests don't retry in lockstep.
+func backoff(attempt int) time.Duration {
+	d := baseBackoff << uint(attempt-1)
+	return d + time.Duration(rand.Int63n(int64(d)/2+1))
+}
+
+// sleep waits for d or returns ctx.Err() if ctx is done first.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
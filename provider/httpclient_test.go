@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetBodyNotFoundIsNotRetried(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	_, err := getBody(context.Background(), srv.URL, nil)
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	if requests != 1 {
+		t.Fatalf("expected exactly 1 request for a non-retryable error, got %d", requests)
+	}
+}
+
+func TestGetBodyRetriesRateLimitUntilExhausted(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	_, err := getBody(context.Background(), srv.URL, nil)
+	if !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("expected ErrRateLimited, got %v", err)
+	}
+	if requests != maxAttempts {
+		t.Fatalf("expected %d attempts, got %d", maxAttempts, requests)
+	}
+}
+
+func TestGetBodyRecoversAfterTransient5xx(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	body, err := getBody(context.Background(), srv.URL, nil)
+	if err != nil {
+		t.Fatalf("getBody: %v", err)
+	}
+	if string(body) != "ok" {
+		t.Fatalf("unexpected body: %s", body)
+	}
+	if requests != 2 {
+		t.Fatalf("expected 1 retry after a transient 5xx, got %d requests", requests)
+	}
+}
+
+func TestGetBodyClientErrorIsNotRetried(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	_, err := getBody(context.Background(), srv.URL, nil)
+	if !errors.Is(err, ErrUpstream) {
+		t.Fatalf("expected ErrUpstream, got %v", err)
+	}
+	if requests != 1 {
+		t.Fatalf("expected exactly 1 request for a non-retryable 4xx, got %d", requests)
+	}
+}
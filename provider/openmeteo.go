@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/stn1sv/weather-forecast/cache"
+)
+
+// Cache freshness windows: geocoding results almost never change, while
+// forecasts go stale quickly.
+const (
+	openMeteoGeocodeTTL  = 30 * 24 * time.Hour
+	openMeteoForecastTTL = 10 * time.Minute
+)
+
+// OpenMeteo is the default Provider, backed by the free, keyless Open-Meteo
+// API.
+type OpenMeteo struct{}
+
+// NewOpenMeteo returns the default Open-Meteo provider.
+func NewOpenMeteo() *OpenMeteo {
+	return &OpenMeteo{}
+}
+
+type openMeteoGeoResponse struct {
+	Results []LatLong `json:"results"`
+}
+
+type openMeteoForecastResponse struct {
+	Timezone string `json:"timezone"`
+	Hourly   struct {
+		Time               []int64   `json:"time"`
+		Temperature2m      []float64 `json:"temperature_2m"`
+		RelativeHumidity2m []float64 `json:"relativehumidity_2m"`
+		WindSpeed10m       []float64 `json:"windspeed_10m"`
+		WindDirection10m   []float64 `json:"winddirection_10m"`
+		WeatherCode        []int     `json:"weathercode"`
+	} `json:"hourly"`
+	Daily struct {
+		Time             []int64   `json:"time"`
+		Temperature2mMax []float64 `json:"temperature_2m_max"`
+		Temperature2mMin []float64 `json:"temperature_2m_min"`
+		PrecipitationSum []float64 `json:"precipitation_sum"`
+		Sunrise          []int64   `json:"sunrise"`
+		Sunset           []int64   `json:"sunset"`
+		WeatherCode      []int     `json:"weathercode"`
+	} `json:"daily"`
+}
+
+func (p *OpenMeteo) Geocode(ctx context.Context, city string) (LatLong, error) {
+	endpoint := fmt.Sprintf("https://geocoding-api.open-meteo.com/v1/search?name=%s&count=1&language=ru&format=json", url.QueryEscape(city))
+
+	body, err := cache.Fetch(endpoint, openMeteoGeocodeTTL, func() ([]byte, error) {
+		return getBody(ctx, endpoint, nil)
+	})
+	if err != nil {
+		return LatLong{}, fmt.Errorf("error making request to Geo API: %w", err)
+	}
+
+	var response openMeteoGeoResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return LatLong{}, fmt.Errorf("error decoding response: %w", err)
+	}
+	if len(response.Results) < 1 {
+		return LatLong{}, fmt.Errorf("%w: no results found", ErrNotFound)
+	}
+
+	return response.Results[0], nil
+}
+
+func (p *OpenMeteo) Fetch(ctx context.Context, latLong LatLong, opts Options) (Forecast, error) {
+	endpoint := fmt.Sprintf(
+		"https://api.open-meteo.com/v1/forecast?latitude=%.6f&longitude=%.6f"+
+			"&hourly=temperature_2m,relativehumidity_2m,windspeed_10m,winddirection_10m,weathercode"+
+			"&daily=temperature_2m_max,temperature_2m_min,sunrise,sunset,precipitation_sum,weathercode"+
+			"&timeformat=unixtime&timezone=auto&temperature_unit=%s&windspeed_unit=%s",
+		latLong.Latitude, latLong.Longitude, temperatureUnit(opts.Units), windspeedUnit(opts.Units),
+	)
+
+	body, err := cache.Fetch(endpoint, openMeteoForecastTTL, func() ([]byte, error) {
+		return getBody(ctx, endpoint, nil)
+	})
+	if err != nil {
+		return Forecast{}, fmt.Errorf("error making request to Weather API: %w", err)
+	}
+
+	var response openMeteoForecastResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return Forecast{}, fmt.Errorf("error decoding weather response: %w", err)
+	}
+
+	return Forecast{
+		Timezone: response.Timezone,
+		Hourly:   extractHourly(response),
+		Daily:    extractDaily(response),
+	}, nil
+}
+
+// extractHourly pulls the hourly substruct out of an Open-Meteo forecast
+// response into normalized points.
+func extractHourly(response openMeteoForecastResponse) []HourlyPoint {
+	points := make([]HourlyPoint, 0, len(response.Hourly.Time))
+	for i, t := range response.Hourly.Time {
+		point := HourlyPoint{Time: t, Temperature: response.Hourly.Temperature2m[i]}
+		if i < len(response.Hourly.RelativeHumidity2m) {
+			point.Humidity = response.Hourly.RelativeHumidity2m[i]
+		}
+		if i < len(response.Hourly.WindSpeed10m) {
+			point.WindSpeed = response.Hourly.WindSpeed10m[i]
+		}
+		if i < len(response.Hourly.WindDirection10m) {
+			point.WindDirection = response.Hourly.WindDirection10m[i]
+		}
+		if i < len(response.Hourly.WeatherCode) {
+			point.WeatherCode = response.Hourly.WeatherCode[i]
+		}
+		points = append(points, point)
+	}
+	return points
+}
+
+// extractDaily pulls the daily substruct out of an Open-Meteo forecast
+// response into normalized points.
+func extractDaily(response openMeteoForecastResponse) []DailyPoint {
+	points := make([]DailyPoint, 0, len(response.Daily.Time))
+	for i, t := range response.Daily.Time {
+		point := DailyPoint{Date: t}
+		if i < len(response.Daily.Temperature2mMax) {
+			point.TemperatureMax = response.Daily.Temperature2mMax[i]
+		}
+		if i < len(response.Daily.Temperature2mMin) {
+			point.TemperatureMin = response.Daily.Temperature2mMin[i]
+		}
+		if i < len(response.Daily.PrecipitationSum) {
+			point.PrecipitationSum = response.Daily.PrecipitationSum[i]
+		}
+		if i < len(response.Daily.Sunrise) {
+			point.Sunrise = response.Daily.Sunrise[i]
+		}
+		if i < len(response.Daily.Sunset) {
+			point.Sunset = response.Daily.Sunset[i]
+		}
+		if i < len(response.Daily.WeatherCode) {
+			point.WeatherCode = response.Daily.WeatherCode[i]
+		}
+		points = append(points, point)
+	}
+	return points
+}
+
+// temperatureUnit returns the Open-Meteo `temperature_unit` query value for u.
+func temperatureUnit(u Units) string {
+	if u == UnitsImperial {
+		return "fahrenheit"
+	}
+	return "celsius"
+}
+
+// windspeedUnit returns the Open-Meteo `windspeed_unit` query value for u.
+func windspeedUnit(u Units) string {
+	if u == UnitsImperial {
+		return "mph"
+	}
+	return "kmh"
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/stn1sv/weather-forecast/cache"
+)
+
+const (
+	owmGeocodeTTL  = 30 * 24 * time.Hour
+	owmForecastTTL = 10 * time.Minute
+)
+
+// OpenWeatherMap fetches geocoding and forecast data from the OpenWeatherMap
+// API, which requires an API key on every request.
+type OpenWeatherMap struct {
+	apiKey string
+}
+
+// NewOpenWeatherMap returns a Provider backed by OpenWeatherMap.
+func NewOpenWeatherMap(apiKey string) *OpenWeatherMap {
+	return &OpenWeatherMap{apiKey: apiKey}
+}
+
+type owmGeoResult struct {
+	Lat float64 `json:"lat"`
+	Lon float64 `json:"lon"`
+}
+
+type owmForecastResponse struct {
+	City struct {
+		Timezone int `json:"timezone"` // seconds east of UTC
+	} `json:"city"`
+	List []struct {
+		Dt   int64 `json:"dt"`
+		Main struct {
+			Temp float64 `json:"temp"`
+		} `json:"main"`
+	} `json:"list"`
+}
+
+func (p *OpenWeatherMap) Geocode(ctx context.Context, city string) (LatLong, error) {
+	endpoint := fmt.Sprintf("https://api.openweathermap.org/geo/1.0/direct?q=%s&limit=1&appid=%s", url.QueryEscape(city), p.apiKey)
+
+	body, err := cache.Fetch(endpoint, owmGeocodeTTL, func() ([]byte, error) {
+		return getBody(ctx, endpoint, nil)
+	})
+	if err != nil {
+		return LatLong{}, fmt.Errorf("error making request to OpenWeatherMap geocoding API: %w", err)
+	}
+
+	var results []owmGeoResult
+	if err := json.Unmarshal(body, &results); err != nil {
+		return LatLong{}, fmt.Errorf("error decoding response: %w", err)
+	}
+	if len(results) < 1 {
+		return LatLong{}, fmt.Errorf("%w: no results found", ErrNotFound)
+	}
+
+	return LatLong{Latitude: results[0].Lat, Longitude: results[0].Lon}, nil
+}
+
+func (p *OpenWeatherMap) Fetch(ctx context.Context, latLong LatLong, opts Options) (Forecast, error) {
+	endpoint := fmt.Sprintf(
+		"https://api.openweathermap.org/data/2.5/forecast?lat=%.6f&lon=%.6f&units=%s&appid=%s",
+		latLong.Latitude, latLong.Longitude, owmUnits(opts.Units), p.apiKey,
+	)
+
+	body, err := cache.Fetch(endpoint, owmForecastTTL, func() ([]byte, error) {
+		return getBody(ctx, endpoint, nil)
+	})
+	if err != nil {
+		return Forecast{}, fmt.Errorf("error making request to OpenWeatherMap forecast API: %w", err)
+	}
+
+	var response owmForecastResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return Forecast{}, fmt.Errorf("error decoding weather response: %w", err)
+	}
+
+	// item.Dt is a genuine UTC instant; shift it by the city's UTC offset so
+	// it matches the rest of the app's "pre-shifted unixtime" convention
+	// (see HourlyPoint.Time).
+	offset := int64(response.City.Timezone)
+	points := make([]HourlyPoint, 0, len(response.List))
+	for _, item := range response.List {
+		points = append(points, HourlyPoint{Time: item.Dt + offset, Temperature: item.Main.Temp})
+	}
+	return Forecast{Hourly: points}, nil
+}
+
+// owmUnits maps our Units to OpenWeatherMap's `units` query value.
+func owmUnits(u Units) string {
+	switch u {
+	case UnitsImperial:
+		return "imperial"
+	case UnitsStandard:
+		return "standard"
+	default:
+		return "metric"
+	}
+}
@@ -0,0 +1,95 @@
+// Package provider abstracts over weather data sources so the rest of the
+// app (HTTP handlers, gRPC service) can work against one normalized shape
+// regardless of which upstream API answers a request.
+package provider
+
+import (
+	"context"
+	"fmt"
+)
+
+// Units selects the measurement system used for temperature and wind speed.
+type Units int
+
+const (
+	UnitsMetric Units = iota
+	UnitsImperial
+	UnitsStandard
+)
+
+// LatLong is a geographic coordinate pair, plus the IANA timezone name at
+// that location when the geocoder supplied one. Fetch receives the same
+// LatLong Geocode returned, so a provider whose own forecast response
+// doesn't carry timezone info (yr.no) can still convert to local time.
+type LatLong struct {
+	Latitude  float64
+	Longitude float64
+	Timezone  string `json:"timezone"`
+}
+
+// Options configures a Fetch call.
+type Options struct {
+	Units Units
+}
+
+// HourlyPoint is a single hourly forecast reading, normalized across
+// providers. Not every provider fills every field; zero values mean the
+// field wasn't available. Time is a Unix timestamp already shifted to the
+// forecast's local timezone, per Open-Meteo's unixtime convention; every
+// provider is responsible for normalizing to it before returning points.
+type HourlyPoint struct {
+	Time          int64
+	Temperature   float64
+	Humidity      float64 // relative humidity, percent
+	WindSpeed     float64
+	WindDirection float64 // degrees
+	WeatherCode   int     // WMO code; see ConditionFromCode
+}
+
+// DailyPoint is a single day's forecast summary, normalized across
+// providers. Times (Date, Sunrise, Sunset) are Unix timestamps already
+// shifted to the forecast's local timezone, the same convention HourlyPoint
+// uses.
+type DailyPoint struct {
+	Date             int64
+	TemperatureMax   float64
+	TemperatureMin   float64
+	PrecipitationSum float64
+	Sunrise          int64
+	Sunset           int64
+	WeatherCode      int
+}
+
+// Forecast is a normalized forecast, regardless of which provider produced
+// it. Not every provider fills Daily; an empty slice means it wasn't
+// available.
+type Forecast struct {
+	Timezone string
+	Hourly   []HourlyPoint
+	Daily    []DailyPoint
+}
+
+// Provider geocodes city names and fetches forecasts from a single upstream
+// weather API.
+type Provider interface {
+	Geocode(ctx context.Context, city string) (LatLong, error)
+	Fetch(ctx context.Context, latLong LatLong, opts Options) (Forecast, error)
+}
+
+// New constructs the Provider registered under name. apiKey is only used by
+// providers that require one (OpenWeatherMap); it's ignored otherwise.
+func New(name string, apiKey string) (Provider, error) {
+	switch name {
+	case "", "open-meteo", "openmeteo":
+		return NewOpenMeteo(), nil
+	case "openweathermap", "owm":
+		if apiKey == "" {
+			return nil, fmt.Errorf("openweathermap provider requires an API key")
+		}
+		return NewOpenWeatherMap(apiKey), nil
+	case "yrno", "yr.no", "met.no", "metno":
+		return NewYrNo(), nil
+	default:
+		return nil, fmt.Errorf("unknown weather provider %q", name)
+	}
+}
@@ -0,0 +1,53 @@
+package provider
+
+// Condition is a human-readable description of a WMO weather code, plus an
+// icon slug views/weather.html can map to an image.
+type Condition struct {
+	Description string
+	Icon        string
+}
+
+// weatherCodes maps Open-Meteo's WMO weathercode values to a short
+// description and icon slug. See
+// https://open-meteo.com/en/docs#weathervariables for the full table; only
+// the codes we render are listed here.
+var weatherCodes = map[int]Condition{
+	0:  {"Clear sky", "clear"},
+	1:  {"Mainly clear", "partly-cloudy"},
+	2:  {"Partly cloudy", "partly-cloudy"},
+	3:  {"Overcast", "cloudy"},
+	45: {"Fog", "fog"},
+	48: {"Depositing rime fog", "fog"},
+	51: {"Light drizzle", "drizzle"},
+	53: {"Moderate drizzle", "drizzle"},
+	55: {"Dense drizzle", "drizzle"},
+	56: {"Light freezing drizzle", "drizzle"},
+	57: {"Dense freezing drizzle", "drizzle"},
+	61: {"Light rain", "rain"},
+	63: {"Moderate rain", "rain"},
+	65: {"Heavy rain", "rain"},
+	66: {"Light freezing rain", "rain"},
+	67: {"Heavy freezing rain", "rain"},
+	71: {"Light snow", "snow"},
+	73: {"Moderate snow", "snow"},
+	75: {"Heavy snow", "snow"},
+	77: {"Snow grains", "snow"},
+	80: {"Light rain showers", "rain"},
+	81: {"Moderate rain showers", "rain"},
+	82: {"Violent rain showers", "rain"},
+	85: {"Light snow showers", "snow"},
+	86: {"Heavy snow showers", "snow"},
+	95: {"Thunderstorm", "thunderstorm"},
+	96: {"Thunderstorm with light hail", "thunderstorm"},
+	99: {"Thunderstorm with heavy hail", "thunderstorm"},
+}
+
+// ConditionFromCode maps an Open-Meteo WMO weathercode to a human-readable
+// condition. Unknown codes fall back to a generic description rather than
+// erroring, since an unmapped code shouldn't break rendering.
+func ConditionFromCode(code int) Condition {
+	if c, ok := weatherCodes[code]; ok {
+		return c
+	}
+	return Condition{Description: "Unknown", Icon: "unknown"}
+}
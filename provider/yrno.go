@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/stn1sv/weather-forecast/cache"
+)
+
+const (
+	yrNoForecastTTL = 10 * time.Minute
+
+	// yrNoUserAgent is required by MET Norway's terms of use: every client
+	// must identify itself, ideally with a contact URL or email.
+	yrNoUserAgent = "weather-forecast/1.0 github.com/stn1sv/weather-forecast"
+)
+
+// YrNo fetches forecasts from the MET Norway (yr.no) Locationforecast API.
+// It has no geocoding endpoint of its own, so it delegates city lookups to
+// Open-Meteo's geocoding API.
+type YrNo struct {
+	geocoder *OpenMeteo
+}
+
+// NewYrNo returns a Provider backed by MET Norway.
+func NewYrNo() *YrNo {
+	return &YrNo{geocoder: NewOpenMeteo()}
+}
+
+type yrNoResponse struct {
+	Properties struct {
+		Timeseries []struct {
+			Time string `json:"time"`
+			Data struct {
+				Instant struct {
+					Details struct {
+						AirTemperature float64 `json:"air_temperature"`
+					} `json:"details"`
+				} `json:"instant"`
+			} `json:"data"`
+		} `json:"timeseries"`
+	} `json:"properties"`
+}
+
+func (p *YrNo) Geocode(ctx context.Context, city string) (LatLong, error) {
+	return p.geocoder.Geocode(ctx, city)
+}
+
+func (p *YrNo) Fetch(ctx context.Context, latLong LatLong, opts Options) (Forecast, error) {
+	endpoint := fmt.Sprintf(
+		"https://api.met.no/weatherapi/locationforecast/2.0/compact?lat=%.6f&lon=%.6f",
+		latLong.Latitude, latLong.Longitude,
+	)
+
+	body, err := cache.Fetch(endpoint, yrNoForecastTTL, func() ([]byte, error) {
+		return getBody(ctx, endpoint, map[string]string{"User-Agent": yrNoUserAgent})
+	})
+	if err != nil {
+		return Forecast{}, fmt.Errorf("error making request to MET Norway API: %w", err)
+	}
+
+	var response yrNoResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return Forecast{}, fmt.Errorf("error decoding weather response: %w", err)
+	}
+
+	// MET Norway's timestamps are genuine UTC instants; shift them by the
+	// city's UTC offset (from the geocoder's timezone, if known) so they
+	// match the rest of the app's "pre-shifted unixtime" convention (see
+	// HourlyPoint.Time). Without a known timezone we fall back to leaving
+	// them as real UTC rather than failing the request outright.
+	tzName := latLong.Timezone
+	if tzName == "" {
+		tzName = "UTC"
+	}
+	loc, err := time.LoadLocation(tzName)
+	if err != nil {
+		log.Printf("yrno: could not load timezone %q, falling back to UTC: %v", tzName, err)
+		loc = time.UTC
+	}
+	_, offset := time.Now().In(loc).Zone()
+
+	points := make([]HourlyPoint, 0, len(response.Properties.Timeseries))
+	for _, ts := range response.Properties.Timeseries {
+		t, err := time.Parse(time.RFC3339, ts.Time)
+		if err != nil {
+			continue
+		}
+		temp := ts.Data.Instant.Details.AirTemperature
+		if opts.Units == UnitsImperial {
+			temp = temp*9/5 + 32
+		}
+		points = append(points, HourlyPoint{Time: t.Unix() + int64(offset), Temperature: temp})
+	}
+	return Forecast{Timezone: tzName, Hourly: points}, nil
+}
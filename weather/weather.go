@@ -0,0 +1,96 @@
+// Package weather is the app's entry point for geocoding and forecast
+// lookups. It delegates to a pluggable provider.Provider so the HTTP
+// handlers and gRPC service don't need to know which upstream weather API
+// is actually in use.
+package weather
+
+import (
+	"context"
+
+	"github.com/stn1sv/weather-forecast/provider"
+)
+
+// Units selects the measurement system used for temperature and wind speed.
+type Units = provider.Units
+
+const (
+	UnitsMetric   = provider.UnitsMetric
+	UnitsImperial = provider.UnitsImperial
+	UnitsStandard = provider.UnitsStandard
+)
+
+// LatLong is a geographic coordinate pair, plus the IANA timezone name at
+// that location when the geocoder supplied one.
+type LatLong struct {
+	Latitude  float64
+	Longitude float64
+	Timezone  string
+}
+
+// Typed upstream errors, re-exported from provider so callers don't need to
+// import it just to check error identity.
+var (
+	ErrNotFound    = provider.ErrNotFound
+	ErrRateLimited = provider.ErrRateLimited
+	ErrUpstream    = provider.ErrUpstream
+)
+
+// HourlyPoint is a single hourly temperature reading.
+type HourlyPoint = provider.HourlyPoint
+
+// DailyPoint is a single day's forecast summary, including sunrise/sunset.
+type DailyPoint = provider.DailyPoint
+
+// active is the provider backing GetLatLong and GetForecast. It defaults to
+// Open-Meteo, the original keyless backend.
+var active provider.Provider = provider.NewOpenMeteo()
+
+// SetProvider swaps the active provider, e.g. with a provider.Fake in tests.
+func SetProvider(p provider.Provider) {
+	active = p
+}
+
+// SelectProvider sets the active provider by name ("open-meteo",
+// "openweathermap", "yrno"); apiKey is only required by providers that need
+// one.
+func SelectProvider(name, apiKey string) error {
+	p, err := provider.New(name, apiKey)
+	if err != nil {
+		return err
+	}
+	active = p
+	return nil
+}
+
+// GetLatLong geocodes city to its first matching coordinates. ctx bounds
+// the request and is honored by the active provider's HTTP client.
+func GetLatLong(ctx context.Context, city string) (*LatLong, error) {
+	ll, err := active.Geocode(ctx, city)
+	if err != nil {
+		return nil, err
+	}
+	return &LatLong{Latitude: ll.Latitude, Longitude: ll.Longitude, Timezone: ll.Timezone}, nil
+}
+
+// GetForecast fetches the hourly forecast for latLong in the given units.
+func GetForecast(ctx context.Context, latLong LatLong, units Units) ([]HourlyPoint, error) {
+	forecast, err := active.Fetch(ctx, toProviderLatLong(latLong), provider.Options{Units: units})
+	if err != nil {
+		return nil, err
+	}
+	return forecast.Hourly, nil
+}
+
+// GetDailyForecast fetches the 7-day forecast summary for latLong in the
+// given units.
+func GetDailyForecast(ctx context.Context, latLong LatLong, units Units) ([]DailyPoint, error) {
+	forecast, err := active.Fetch(ctx, toProviderLatLong(latLong), provider.Options{Units: units})
+	if err != nil {
+		return nil, err
+	}
+	return forecast.Daily, nil
+}
+
+func toProviderLatLong(latLong LatLong) provider.LatLong {
+	return provider.LatLong{Latitude: latLong.Latitude, Longitude: latLong.Longitude, Timezone: latLong.Timezone}
+}
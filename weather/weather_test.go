@@ -0,0 +1,35 @@
+package weather
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stn1sv/weather-forecast/provider"
+)
+
+func TestGetForecastUsesActiveProvider(t *testing.T) {
+	SetProvider(provider.NewFake(
+		provider.LatLong{Latitude: 51.5, Longitude: -0.12},
+		provider.Forecast{Hourly: []provider.HourlyPoint{{Time: 1700000000, Temperature: 12.3}}},
+		nil,
+	))
+	defer SetProvider(provider.NewOpenMeteo())
+
+	ctx := context.Background()
+
+	latLong, err := GetLatLong(ctx, "London")
+	if err != nil {
+		t.Fatalf("GetLatLong: %v", err)
+	}
+	if latLong.Latitude != 51.5 || latLong.Longitude != -0.12 {
+		t.Fatalf("unexpected coordinates: %+v", latLong)
+	}
+
+	points, err := GetForecast(ctx, *latLong, UnitsMetric)
+	if err != nil {
+		t.Fatalf("GetForecast: %v", err)
+	}
+	if len(points) != 1 || points[0].Temperature != 12.3 {
+		t.Fatalf("unexpected points: %+v", points)
+	}
+}